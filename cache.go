@@ -0,0 +1,14 @@
+package main
+
+// Cache stores and retrieves raw JSON response bodies keyed by request URL,
+// so repeated runs during development don't re-spend API credits for data
+// that hasn't gone stale yet.
+type Cache interface {
+	// Get returns the cached body for key and whether it was found and
+	// still within its TTL.
+	Get(key string) ([]byte, bool)
+	// Set stores body under key.
+	Set(key string, body []byte) error
+	// Clean removes everything the cache has stored.
+	Clean() error
+}
@@ -0,0 +1,160 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"time"
+
+	"golang.org/x/time/rate"
+)
+
+// CMCProvider fetches categories and category coins from CoinMarketCap.
+type CMCProvider struct {
+	APIKey  string
+	BaseURL string
+
+	// Limiter throttles outgoing requests to stay within CMC's per-minute
+	// credit budget, regardless of how many goroutines call this provider
+	// concurrently.
+	Limiter *rate.Limiter
+
+	// Cache memoizes responses by request URL so repeated runs don't burn
+	// CMC credits on data that hasn't gone stale. Nil disables caching.
+	Cache Cache
+}
+
+// NewCMCProvider builds a CMCProvider from the given API key, rate-limited
+// to CMC's basic-plan credit budget of roughly 30 calls/minute. cache may be
+// nil to disable response caching.
+func NewCMCProvider(apiKey string, cache Cache) *CMCProvider {
+	return &CMCProvider{
+		APIKey:  apiKey,
+		BaseURL: "https://pro-api.coinmarketcap.com",
+		Limiter: rate.NewLimiter(rate.Every(2*time.Second), 1),
+		Cache:   cache,
+	}
+}
+
+func (p *CMCProvider) Name() string {
+	return "coinmarketcap"
+}
+
+// GetCategories fetches the list of all categories from CoinMarketCap.
+func (p *CMCProvider) GetCategories(ctx context.Context) ([]Category, error) {
+	url := fmt.Sprintf("%s/v1/cryptocurrency/categories", p.BaseURL)
+
+	if p.Cache != nil {
+		if body, ok := p.Cache.Get(url); ok {
+			log.Printf("cache hit: %s", url)
+			var response CategoryResponse
+			if err := json.Unmarshal(body, &response); err == nil {
+				return response.Data, nil
+			}
+		}
+	}
+	log.Printf("cache miss: %s", url)
+
+	if err := p.Limiter.Wait(ctx); err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("X-CMC_PRO_API_KEY", p.APIKey)
+
+	client := &http.Client{}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to send request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return nil, &HTTPStatusError{Provider: p.Name(), StatusCode: resp.StatusCode, Body: string(body)}
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	var response CategoryResponse
+	if err := json.Unmarshal(body, &response); err != nil {
+		return nil, fmt.Errorf("failed to parse response: %w", err)
+	}
+
+	if p.Cache != nil {
+		if err := p.Cache.Set(url, body); err != nil {
+			log.Printf("cache write failed for %s: %v", url, err)
+		}
+	}
+
+	return response.Data, nil
+}
+
+// GetCoinsForCategory fetches the list of coins for a specific category.
+func (p *CMCProvider) GetCoinsForCategory(ctx context.Context, categoryID string) ([]Coin, error) {
+	url := fmt.Sprintf("%s/v1/cryptocurrency/category?id=%s&limit=100&convert=USD", p.BaseURL, categoryID)
+
+	if p.Cache != nil {
+		if body, ok := p.Cache.Get(url); ok {
+			log.Printf("cache hit: %s", url)
+			var response CategoryCoinsResponse
+			if err := json.Unmarshal(body, &response); err == nil {
+				return response.Data.Coins, nil
+			}
+		}
+	}
+	log.Printf("cache miss: %s", url)
+
+	if err := p.Limiter.Wait(ctx); err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("X-CMC_PRO_API_KEY", p.APIKey)
+
+	client := &http.Client{}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to send request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return nil, &HTTPStatusError{Provider: p.Name(), StatusCode: resp.StatusCode, Body: string(body)}
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	var response CategoryCoinsResponse
+	if err := json.Unmarshal(body, &response); err != nil {
+		return nil, fmt.Errorf("failed to parse response: %w", err)
+	}
+
+	if p.Cache != nil {
+		if err := p.Cache.Set(url, body); err != nil {
+			log.Printf("cache write failed for %s: %v", url, err)
+		}
+	}
+
+	return response.Data.Coins, nil
+}
@@ -0,0 +1,139 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+)
+
+// CoinGeckoProvider fetches categories and category coins from the public
+// CoinGecko API. It requires no API key, which makes it a natural fallback
+// when CMC_API_KEY is unset or CoinMarketCap is unavailable.
+type CoinGeckoProvider struct {
+	BaseURL string
+}
+
+// NewCoinGeckoProvider builds a CoinGeckoProvider pointed at the public API.
+func NewCoinGeckoProvider() *CoinGeckoProvider {
+	return &CoinGeckoProvider{
+		BaseURL: "https://api.coingecko.com/api/v3",
+	}
+}
+
+func (p *CoinGeckoProvider) Name() string {
+	return "coingecko"
+}
+
+// coinGeckoCategory mirrors the shape of /coins/categories.
+type coinGeckoCategory struct {
+	Id                string  `json:"id"`
+	Name              string  `json:"name"`
+	Content           string  `json:"content"`
+	MarketCap         float64 `json:"market_cap"`
+	MarketCapChange24 float64 `json:"market_cap_change_24h"`
+	Volume24h         float64 `json:"volume_24h"`
+}
+
+// GetCategories fetches the list of all categories from CoinGecko.
+func (p *CoinGeckoProvider) GetCategories(ctx context.Context) ([]Category, error) {
+	url := fmt.Sprintf("%s/coins/categories", p.BaseURL)
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Accept", "application/json")
+
+	client := &http.Client{}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to send request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return nil, &HTTPStatusError{Provider: p.Name(), StatusCode: resp.StatusCode, Body: string(body)}
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	var raw []coinGeckoCategory
+	if err := json.Unmarshal(body, &raw); err != nil {
+		return nil, fmt.Errorf("failed to parse response: %w", err)
+	}
+
+	categories := make([]Category, 0, len(raw))
+	for _, c := range raw {
+		categories = append(categories, Category{
+			Id:              c.Id,
+			Name:            c.Name,
+			Title:           c.Name,
+			Description:     c.Content,
+			MarketCap:       c.MarketCap,
+			MarketCapChange: c.MarketCapChange24,
+			Volume:          c.Volume24h,
+		})
+	}
+
+	return categories, nil
+}
+
+// coinGeckoMarketCoin mirrors the shape of /coins/markets.
+type coinGeckoMarketCoin struct {
+	Symbol      string  `json:"symbol"`
+	Name        string  `json:"name"`
+	MarketCap   float64 `json:"market_cap"`
+	TotalVolume float64 `json:"total_volume"`
+}
+
+// GetCoinsForCategory fetches the coins belonging to a category from
+// CoinGecko's markets endpoint, filtered by category id.
+func (p *CoinGeckoProvider) GetCoinsForCategory(ctx context.Context, categoryID string) ([]Coin, error) {
+	url := fmt.Sprintf("%s/coins/markets?vs_currency=usd&category=%s&per_page=250", p.BaseURL, categoryID)
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Accept", "application/json")
+
+	client := &http.Client{}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to send request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return nil, &HTTPStatusError{Provider: p.Name(), StatusCode: resp.StatusCode, Body: string(body)}
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	var raw []coinGeckoMarketCoin
+	if err := json.Unmarshal(body, &raw); err != nil {
+		return nil, fmt.Errorf("failed to parse response: %w", err)
+	}
+
+	coins := make([]Coin, 0, len(raw))
+	for _, c := range raw {
+		coin := Coin{
+			Symbol: strings.ToUpper(c.Symbol),
+			Name:   c.Name,
+		}
+		coin.Quote.USD.MarketCap = c.MarketCap
+		coin.Quote.USD.Volume24h = c.TotalVolume
+		coins = append(coins, coin)
+	}
+
+	return coins, nil
+}
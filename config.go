@@ -0,0 +1,62 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// FileConfig holds the user's persisted filter preferences, loaded from
+// ~/.config/coins_categories.yaml (or a path given via -config). It's
+// merged with the -include/-exclude/-min-market-cap/-min-tokens flags to
+// build the CategoryFilter used for a run.
+type FileConfig struct {
+	Include      []string          `yaml:"include" json:"include"`
+	Exclude      []string          `yaml:"exclude" json:"exclude"`
+	MinMarketCap float64           `yaml:"min_market_cap" json:"min_market_cap"`
+	MinTokens    int               `yaml:"min_tokens" json:"min_tokens"`
+	Pins         map[string]string `yaml:"pins" json:"pins"`
+}
+
+// defaultConfigPath returns ~/.config/coins_categories.yaml, or "" if the
+// home directory can't be resolved.
+func defaultConfigPath() string {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return ""
+	}
+	return filepath.Join(home, ".config", "coins_categories.yaml")
+}
+
+// loadFileConfig reads path as YAML or JSON (by extension). A missing file
+// is not an error; it just yields an empty FileConfig.
+func loadFileConfig(path string) (*FileConfig, error) {
+	if path == "" {
+		return &FileConfig{}, nil
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return &FileConfig{}, nil
+		}
+		return nil, fmt.Errorf("failed to read config %s: %w", path, err)
+	}
+
+	var cfg FileConfig
+	if strings.HasSuffix(path, ".json") {
+		if err := json.Unmarshal(data, &cfg); err != nil {
+			return nil, fmt.Errorf("failed to parse config %s: %w", path, err)
+		}
+	} else {
+		if err := yaml.Unmarshal(data, &cfg); err != nil {
+			return nil, fmt.Errorf("failed to parse config %s: %w", path, err)
+		}
+	}
+
+	return &cfg, nil
+}
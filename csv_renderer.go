@@ -0,0 +1,37 @@
+package main
+
+import (
+	"encoding/csv"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// CSVRenderer emits one row per category with its listed coins joined into
+// a single field.
+type CSVRenderer struct{}
+
+func (r *CSVRenderer) Render(w io.Writer, reports []categoryReport, listedCoins map[string]struct{}) error {
+	csvWriter := csv.NewWriter(w)
+
+	if err := csvWriter.Write([]string{"category", "market_cap", "market_cap_change", "volume", "coins"}); err != nil {
+		return err
+	}
+
+	for _, report := range reports {
+		category := report.category
+		row := []string{
+			category.Name,
+			strconv.FormatFloat(category.MarketCap, 'f', -1, 64),
+			strconv.FormatFloat(category.MarketCapChange, 'f', -1, 64),
+			strconv.FormatFloat(category.Volume, 'f', -1, 64),
+			strings.Join(reportCoinNames(report, listedCoins), "; "),
+		}
+		if err := csvWriter.Write(row); err != nil {
+			return err
+		}
+	}
+
+	csvWriter.Flush()
+	return csvWriter.Error()
+}
@@ -0,0 +1,85 @@
+package exchanges
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// Binance unions listed assets across Binance's spot, USDM futures, and
+// COIN-M futures markets.
+type Binance struct{}
+
+// NewBinance builds a Binance listing.
+func NewBinance() *Binance {
+	return &Binance{}
+}
+
+func (b *Binance) Name() string {
+	return "binance"
+}
+
+// exchangeInfo mirrors the shape of Binance's exchangeInfo response.
+type exchangeInfo struct {
+	Symbols []struct {
+		BaseAsset  string `json:"baseAsset"`
+		QuoteAsset string `json:"quoteAsset"`
+	} `json:"symbols"`
+}
+
+func (b *Binance) ListedSymbols(ctx context.Context) (map[string]struct{}, error) {
+	assets := make(map[string]struct{})
+
+	spot, err := fetchExchangeInfo(ctx, "https://api.binance.com/api/v3/exchangeInfo")
+	if err != nil {
+		return nil, fmt.Errorf("spot: %w", err)
+	}
+	addAssets(assets, spot, true)
+
+	usdm, err := fetchExchangeInfo(ctx, "https://fapi.binance.com/fapi/v1/exchangeInfo")
+	if err != nil {
+		return nil, fmt.Errorf("usdm futures: %w", err)
+	}
+	addAssets(assets, usdm, true)
+
+	coinM, err := fetchExchangeInfo(ctx, "https://dapi.binance.com/dapi/v1/exchangeInfo")
+	if err != nil {
+		return nil, fmt.Errorf("coin-m futures: %w", err)
+	}
+	addAssets(assets, coinM, false) // quoteAsset is always "USD", not a tradable asset
+
+	return assets, nil
+}
+
+func fetchExchangeInfo(ctx context.Context, url string) (*exchangeInfo, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch %s: %w", url, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status code %d for %s", resp.StatusCode, url)
+	}
+
+	var info exchangeInfo
+	if err := json.NewDecoder(resp.Body).Decode(&info); err != nil {
+		return nil, fmt.Errorf("failed to decode response from %s: %w", url, err)
+	}
+	return &info, nil
+}
+
+func addAssets(dst map[string]struct{}, info *exchangeInfo, includeQuote bool) {
+	for _, symbol := range info.Symbols {
+		dst[symbol.BaseAsset] = struct{}{}
+		if includeQuote {
+			dst[symbol.QuoteAsset] = struct{}{}
+		}
+	}
+}
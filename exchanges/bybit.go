@@ -0,0 +1,66 @@
+package exchanges
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// Bybit unions listed assets across Bybit's spot, linear, and inverse
+// instrument categories.
+type Bybit struct{}
+
+// NewBybit builds a Bybit listing.
+func NewBybit() *Bybit {
+	return &Bybit{}
+}
+
+func (b *Bybit) Name() string {
+	return "bybit"
+}
+
+type bybitInstrumentsResponse struct {
+	Result struct {
+		List []struct {
+			BaseCoin  string `json:"baseCoin"`
+			QuoteCoin string `json:"quoteCoin"`
+		} `json:"list"`
+	} `json:"result"`
+}
+
+func (b *Bybit) ListedSymbols(ctx context.Context) (map[string]struct{}, error) {
+	assets := make(map[string]struct{})
+
+	for _, category := range []string{"spot", "linear", "inverse"} {
+		url := fmt.Sprintf("https://api.bybit.com/v5/market/instruments-info?category=%s", category)
+		req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create request: %w", err)
+		}
+
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			return nil, fmt.Errorf("%s: failed to fetch %s: %w", category, url, err)
+		}
+
+		if resp.StatusCode != http.StatusOK {
+			resp.Body.Close()
+			return nil, fmt.Errorf("%s: unexpected status code %d for %s", category, resp.StatusCode, url)
+		}
+
+		var response bybitInstrumentsResponse
+		err = json.NewDecoder(resp.Body).Decode(&response)
+		resp.Body.Close()
+		if err != nil {
+			return nil, fmt.Errorf("%s: failed to decode response from %s: %w", category, url, err)
+		}
+
+		for _, instrument := range response.Result.List {
+			assets[instrument.BaseCoin] = struct{}{}
+			assets[instrument.QuoteCoin] = struct{}{}
+		}
+	}
+
+	return assets, nil
+}
@@ -0,0 +1,55 @@
+package exchanges
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// Coinbase lists assets tradable on Coinbase's spot exchange.
+type Coinbase struct{}
+
+// NewCoinbase builds a Coinbase listing.
+func NewCoinbase() *Coinbase {
+	return &Coinbase{}
+}
+
+func (c *Coinbase) Name() string {
+	return "coinbase"
+}
+
+type coinbaseProduct struct {
+	BaseCurrency  string `json:"base_currency"`
+	QuoteCurrency string `json:"quote_currency"`
+}
+
+func (c *Coinbase) ListedSymbols(ctx context.Context) (map[string]struct{}, error) {
+	url := "https://api.exchange.coinbase.com/products"
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch %s: %w", url, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status code %d for %s", resp.StatusCode, url)
+	}
+
+	var products []coinbaseProduct
+	if err := json.NewDecoder(resp.Body).Decode(&products); err != nil {
+		return nil, fmt.Errorf("failed to decode response from %s: %w", url, err)
+	}
+
+	assets := make(map[string]struct{})
+	for _, product := range products {
+		assets[product.BaseCurrency] = struct{}{}
+		assets[product.QuoteCurrency] = struct{}{}
+	}
+	return assets, nil
+}
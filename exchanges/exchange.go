@@ -0,0 +1,42 @@
+// Package exchanges provides Listing implementations for the
+// venues coins_categories can cross-reference category coins against.
+package exchanges
+
+import (
+	"context"
+	"fmt"
+)
+
+// Listing abstracts fetching the set of symbols an exchange has listed, so
+// callers can union availability across multiple venues instead of being
+// tied to a single exchange.
+type Listing interface {
+	Name() string
+	ListedSymbols(ctx context.Context) (map[string]struct{}, error)
+}
+
+var registry = map[string]func() Listing{
+	"binance":  func() Listing { return NewBinance() },
+	"coinbase": func() Listing { return NewCoinbase() },
+	"bybit":    func() Listing { return NewBybit() },
+	"okx":      func() Listing { return NewOKX() },
+	"kraken":   func() Listing { return NewKraken() },
+}
+
+// Get looks up a Listing by its registered name (e.g. "binance").
+func Get(name string) (Listing, error) {
+	factory, ok := registry[name]
+	if !ok {
+		return nil, fmt.Errorf("unknown exchange %q", name)
+	}
+	return factory(), nil
+}
+
+// Names returns all registered exchange names.
+func Names() []string {
+	names := make([]string, 0, len(registry))
+	for name := range registry {
+		names = append(names, name)
+	}
+	return names
+}
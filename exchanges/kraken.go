@@ -0,0 +1,79 @@
+package exchanges
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// Kraken lists assets tradable on Kraken's spot market.
+type Kraken struct{}
+
+// NewKraken builds a Kraken listing.
+func NewKraken() *Kraken {
+	return &Kraken{}
+}
+
+func (k *Kraken) Name() string {
+	return "kraken"
+}
+
+type krakenAssetPairsResponse struct {
+	Result map[string]struct {
+		// WSName is like "XBT/USD": Kraken's plain ticker symbols, unlike
+		// Base/Quote which are Kraken's internal asset codes (e.g. "XXBT",
+		// "ZUSD") and don't match the symbols other venues report.
+		WSName string `json:"wsname"`
+		Base   string `json:"base"`
+		Quote  string `json:"quote"`
+	} `json:"result"`
+}
+
+func (k *Kraken) ListedSymbols(ctx context.Context) (map[string]struct{}, error) {
+	url := "https://api.kraken.com/0/public/AssetPairs"
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch %s: %w", url, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status code %d for %s", resp.StatusCode, url)
+	}
+
+	var response krakenAssetPairsResponse
+	if err := json.NewDecoder(resp.Body).Decode(&response); err != nil {
+		return nil, fmt.Errorf("failed to decode response from %s: %w", url, err)
+	}
+
+	assets := make(map[string]struct{})
+	for _, pair := range response.Result {
+		if base, quote, ok := strings.Cut(pair.WSName, "/"); ok {
+			assets[base] = struct{}{}
+			assets[quote] = struct{}{}
+			continue
+		}
+		// No wsname (rare, mostly dark-pool pairs): fall back to stripping
+		// Kraken's single-letter asset-class prefix (X for crypto, Z for
+		// fiat) from its internal code, e.g. "XXBT" -> "XBT".
+		assets[krakenCode(pair.Base)] = struct{}{}
+		assets[krakenCode(pair.Quote)] = struct{}{}
+	}
+	return assets, nil
+}
+
+// krakenCode strips Kraken's legacy X/Z asset-class prefix from an internal
+// asset code, e.g. "XXBT" -> "XBT", "ZUSD" -> "USD".
+func krakenCode(code string) string {
+	if len(code) == 4 && (code[0] == 'X' || code[0] == 'Z') {
+		return code[1:]
+	}
+	return code
+}
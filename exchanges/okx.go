@@ -0,0 +1,57 @@
+package exchanges
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// OKX lists assets tradable on OKX's spot market.
+type OKX struct{}
+
+// NewOKX builds an OKX listing.
+func NewOKX() *OKX {
+	return &OKX{}
+}
+
+func (o *OKX) Name() string {
+	return "okx"
+}
+
+type okxInstrumentsResponse struct {
+	Data []struct {
+		BaseCcy  string `json:"baseCcy"`
+		QuoteCcy string `json:"quoteCcy"`
+	} `json:"data"`
+}
+
+func (o *OKX) ListedSymbols(ctx context.Context) (map[string]struct{}, error) {
+	url := "https://www.okx.com/api/v5/public/instruments?instType=SPOT"
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch %s: %w", url, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status code %d for %s", resp.StatusCode, url)
+	}
+
+	var response okxInstrumentsResponse
+	if err := json.NewDecoder(resp.Body).Decode(&response); err != nil {
+		return nil, fmt.Errorf("failed to decode response from %s: %w", url, err)
+	}
+
+	assets := make(map[string]struct{})
+	for _, instrument := range response.Data {
+		assets[instrument.BaseCcy] = struct{}{}
+		assets[instrument.QuoteCcy] = struct{}{}
+	}
+	return assets, nil
+}
@@ -0,0 +1,75 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"sync"
+)
+
+// FallbackProvider tries each underlying provider in order until one
+// answers GetCategories, then sticks with that same provider for every
+// subsequent GetCoinsForCategory call. Category ids are provider-specific
+// (CMC hex ids vs CoinGecko slugs), so once a provider has supplied the
+// category list, only that provider's ids are meaningful; falling back
+// per-call would silently look up the wrong provider's id space. This is
+// what lets the tool keep working against CoinGecko when CMC is
+// rate-limited or has an outage.
+type FallbackProvider struct {
+	Providers []MarketDataProvider
+
+	mu     sync.Mutex
+	active MarketDataProvider
+}
+
+// NewFallbackProvider builds a FallbackProvider over the given providers,
+// tried in the order given.
+func NewFallbackProvider(providers ...MarketDataProvider) *FallbackProvider {
+	return &FallbackProvider{Providers: providers}
+}
+
+func (f *FallbackProvider) Name() string {
+	return "fallback"
+}
+
+func (f *FallbackProvider) GetCategories(ctx context.Context) ([]Category, error) {
+	var errs []error
+	for _, p := range f.Providers {
+		categories, err := p.GetCategories(ctx)
+		if err == nil {
+			f.mu.Lock()
+			f.active = p
+			f.mu.Unlock()
+			return categories, nil
+		}
+		logFallback(p, err)
+		errs = append(errs, fmt.Errorf("%s: %w", p.Name(), err))
+	}
+	return nil, fmt.Errorf("all providers failed: %w", errors.Join(errs...))
+}
+
+// GetCoinsForCategory uses whichever provider last answered GetCategories,
+// since categoryID only makes sense in that provider's id space.
+// GetCategories must be called first.
+func (f *FallbackProvider) GetCoinsForCategory(ctx context.Context, categoryID string) ([]Coin, error) {
+	f.mu.Lock()
+	active := f.active
+	f.mu.Unlock()
+
+	if active == nil {
+		return nil, fmt.Errorf("fallback: GetCategories must succeed before GetCoinsForCategory")
+	}
+	return active.GetCoinsForCategory(ctx, categoryID)
+}
+
+// logFallback logs why a provider was skipped, calling out rate limiting
+// specifically since that's the case FallbackProvider exists to smooth over.
+func logFallback(p MarketDataProvider, err error) {
+	var statusErr *HTTPStatusError
+	if errors.As(err, &statusErr) && statusErr.RateLimited() {
+		log.Printf("%s rate-limited, falling back: %v", p.Name(), err)
+		return
+	}
+	log.Printf("%s failed, falling back: %v", p.Name(), err)
+}
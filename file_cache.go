@@ -0,0 +1,75 @@
+package main
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// FileCache is a filesystem-backed Cache rooted under
+// $XDG_CACHE_HOME/coins_categories/ (or the platform equivalent returned by
+// os.UserCacheDir). Each entry is stored as one file named after the sha256
+// of its key; entries older than TTL are treated as misses.
+type FileCache struct {
+	Dir string
+	TTL time.Duration
+}
+
+// NewFileCache creates the cache directory if needed and returns a
+// FileCache with the given TTL.
+func NewFileCache(ttl time.Duration) (*FileCache, error) {
+	base, err := os.UserCacheDir()
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve cache directory: %w", err)
+	}
+
+	dir := filepath.Join(base, "coins_categories")
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, fmt.Errorf("failed to create cache directory: %w", err)
+	}
+
+	return &FileCache{Dir: dir, TTL: ttl}, nil
+}
+
+func (c *FileCache) path(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return filepath.Join(c.Dir, hex.EncodeToString(sum[:])+".json")
+}
+
+func (c *FileCache) Get(key string) ([]byte, bool) {
+	path := c.path(key)
+
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, false
+	}
+	if time.Since(info.ModTime()) > c.TTL {
+		return nil, false
+	}
+
+	body, err := os.ReadFile(path)
+	if err != nil {
+		return nil, false
+	}
+	return body, true
+}
+
+func (c *FileCache) Set(key string, body []byte) error {
+	return os.WriteFile(c.path(key), body, 0o644)
+}
+
+func (c *FileCache) Clean() error {
+	entries, err := os.ReadDir(c.Dir)
+	if err != nil {
+		return fmt.Errorf("failed to read cache directory: %w", err)
+	}
+	for _, entry := range entries {
+		if err := os.Remove(filepath.Join(c.Dir, entry.Name())); err != nil {
+			return fmt.Errorf("failed to remove %s: %w", entry.Name(), err)
+		}
+	}
+	return nil
+}
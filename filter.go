@@ -0,0 +1,70 @@
+package main
+
+import (
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+// CategoryFilter decides which categories main reports on, replacing the
+// old hardcoded allowedCategories map with user-configurable rules.
+type CategoryFilter struct {
+	Include      []string
+	Exclude      []string
+	MinMarketCap float64
+	MinTokens    int
+	// Pins maps a category id to a display alias. Pinned categories are
+	// always included regardless of Include/Exclude/thresholds, mirroring
+	// the old allowlist's id -> display-string entries.
+	Pins map[string]string
+}
+
+// Matches reports whether category should be included in the report.
+func (f *CategoryFilter) Matches(category Category) bool {
+	if _, pinned := f.Pins[category.Id]; pinned {
+		return true
+	}
+
+	if category.MarketCap < f.MinMarketCap || category.NumTokens < f.MinTokens {
+		return false
+	}
+	if len(f.Include) > 0 && !matchesAny(f.Include, category) {
+		return false
+	}
+	if matchesAny(f.Exclude, category) {
+		return false
+	}
+	return true
+}
+
+func matchesAny(patterns []string, category Category) bool {
+	for _, pattern := range patterns {
+		pattern = strings.TrimSpace(pattern)
+		if pattern == "" {
+			continue
+		}
+		if matchPattern(pattern, category.Name) || matchPattern(pattern, category.Title) {
+			return true
+		}
+	}
+	return false
+}
+
+// matchPattern matches s against pattern, treating pattern as a regex if it
+// contains regex metacharacters that aren't also valid glob syntax, and as
+// a shell glob (*, ?, [...]) otherwise.
+func matchPattern(pattern, s string) bool {
+	if strings.ContainsAny(pattern, "^$+()|\\") {
+		re, err := regexp.Compile(pattern)
+		if err != nil {
+			return false
+		}
+		return re.MatchString(s)
+	}
+
+	matched, err := filepath.Match(pattern, s)
+	if err != nil {
+		return false
+	}
+	return matched
+}
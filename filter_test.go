@@ -0,0 +1,82 @@
+package main
+
+import "testing"
+
+func TestMatchPattern(t *testing.T) {
+	tests := []struct {
+		pattern string
+		s       string
+		want    bool
+	}{
+		{"DeFi", "DeFi", true},
+		{"DeFi", "Stablecoin", false},
+		{"*Ecosystem", "Solana Ecosystem", true},
+		{"*Ecosystem", "Ecosystem Tokens", false},
+		{"Layer *", "Layer 1", true},
+		{"Layer *", "Layer1", false},
+		{"^Layer [0-9]+$", "Layer 2", true},
+		{"^Layer [0-9]+$", "Layer Two", false},
+		{"[", "anything", false}, // invalid glob/regex should not match, not panic
+	}
+
+	for _, tt := range tests {
+		if got := matchPattern(tt.pattern, tt.s); got != tt.want {
+			t.Errorf("matchPattern(%q, %q) = %v, want %v", tt.pattern, tt.s, got, tt.want)
+		}
+	}
+}
+
+func TestCategoryFilterMatches(t *testing.T) {
+	filter := &CategoryFilter{
+		Include:      []string{"*Ecosystem"},
+		Exclude:      []string{"*Portfolio*"},
+		MinMarketCap: 1000,
+		MinTokens:    10,
+		Pins:         map[string]string{"pinned-id": "Pinned Category"},
+	}
+
+	tests := []struct {
+		name     string
+		category Category
+		want     bool
+	}{
+		{
+			name:     "matches include, above thresholds",
+			category: Category{Name: "Solana Ecosystem", MarketCap: 5000, NumTokens: 20},
+			want:     true,
+		},
+		{
+			name:     "below market cap threshold",
+			category: Category{Name: "Solana Ecosystem", MarketCap: 500, NumTokens: 20},
+			want:     false,
+		},
+		{
+			name:     "below token threshold",
+			category: Category{Name: "Solana Ecosystem", MarketCap: 5000, NumTokens: 1},
+			want:     false,
+		},
+		{
+			name:     "does not match include pattern",
+			category: Category{Name: "Stablecoin", MarketCap: 5000, NumTokens: 20},
+			want:     false,
+		},
+		{
+			name:     "matches exclude pattern",
+			category: Category{Name: "Portfolio Ecosystem", MarketCap: 5000, NumTokens: 20},
+			want:     false,
+		},
+		{
+			name:     "pinned id bypasses thresholds and patterns",
+			category: Category{Id: "pinned-id", Name: "Stablecoin", MarketCap: 0, NumTokens: 0},
+			want:     true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := filter.Matches(tt.category); got != tt.want {
+				t.Errorf("Matches(%+v) = %v, want %v", tt.category, got, tt.want)
+			}
+		})
+	}
+}
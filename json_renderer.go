@@ -0,0 +1,48 @@
+package main
+
+import (
+	"encoding/json"
+	"io"
+)
+
+// JSONRenderer emits a single JSON array holding one object per category,
+// suitable for piping into jq or feeding downstream tools/dashboards.
+type JSONRenderer struct{}
+
+// jsonCoin is one coin in a JSONRenderer category object. OnBinance keeps
+// its original name for schema stability even though -exchanges can now
+// select venues other than Binance; it reflects whatever set was selected.
+type jsonCoin struct {
+	Symbol    string `json:"symbol"`
+	OnBinance bool   `json:"on_binance"`
+}
+
+type jsonCategory struct {
+	Category        string     `json:"category"`
+	MarketCap       float64    `json:"market_cap"`
+	MarketCapChange float64    `json:"market_cap_change"`
+	Volume          float64    `json:"volume"`
+	Coins           []jsonCoin `json:"coins"`
+}
+
+func (r *JSONRenderer) Render(w io.Writer, reports []categoryReport, listedCoins map[string]struct{}) error {
+	out := make([]jsonCategory, 0, len(reports))
+	for _, report := range reports {
+		coins := make([]jsonCoin, 0, len(report.coins))
+		for _, coin := range report.coins {
+			_, onBinance := listedCoins[coin.Symbol]
+			coins = append(coins, jsonCoin{Symbol: coin.Symbol, OnBinance: onBinance})
+		}
+		out = append(out, jsonCategory{
+			Category:        report.category.Name,
+			MarketCap:       report.category.MarketCap,
+			MarketCapChange: report.category.MarketCapChange,
+			Volume:          report.category.Volume,
+			Coins:           coins,
+		})
+	}
+
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(out)
+}
@@ -1,17 +1,21 @@
 package main
 
 import (
-	"encoding/json"
+	"context"
+	"flag"
 	"fmt"
 	"io"
 	"log"
-	"net/http"
 	"os"
 	"sort"
 	"strings"
+	"sync"
+	"time"
+
+	"github.com/chaotic-cat/coins_categories/exchanges"
 )
 
-// Category represents a CoinMarketCap category
+// Category represents a token category as reported by a MarketDataProvider
 type Category struct {
 	Id              string  `json:"id"`
 	Name            string  `json:"name"`
@@ -49,53 +53,95 @@ type CategoryCoinsResponse struct {
 	} `json:"data"`
 }
 
-// Config holds the API configuration
-type Config struct {
-	APIKey  string
-	BaseURL string
+// newDefaultProvider builds the MarketDataProvider used by main: CMC when
+// CMC_API_KEY is set, falling back to CoinGecko when it isn't, CMC is
+// rate-limited, or CMC otherwise errors. cache may be nil to disable
+// response caching for the CMC provider.
+func newDefaultProvider(cache Cache) MarketDataProvider {
+	coinGecko := NewCoinGeckoProvider()
+
+	apiKey := os.Getenv("CMC_API_KEY") // Set your API key in environment variable CMC_API_KEY
+	if apiKey == "" {
+		fmt.Println("CMC_API_KEY not set, using CoinGecko")
+		return coinGecko
+	}
+
+	return NewFallbackProvider(NewCMCProvider(apiKey, cache), coinGecko)
 }
 
-var allowedCategories = map[string]string{
-	"6433de7df79a2653906cd680": "Layer 1[120]",
-	"67c514446feebc2b5bcc23f1": "US Strategic Crypto Reserve[5]",
-	"604f2772ebccdd50cd175fd9": "Coinbase Ventures Portfolio[63]",
-	"63feda8ad0a19758f3bde124": "Bitcoin Ecosystem[176]",
-	"618c0beeb7dd913155b462f9": "Ethereum Ecosystem[3411]",
-	"5fb62883c9ddcc213ed13308": "DeFi[1998]",
-	"604f2753ebccdd50cd175fc1": "Stablecoin[226]",
-	"6634dccba7b6f0637eec196a": "Fiat Stablecoin[26]",
-	"60521ff1df5d3f36b84fbb61": "Solana Ecosystem[2212]",
-	"60308028d2088f200c58a005": "BNB Chain Ecosystem[4029]",
-	"6171122402ece807e8a9d3ed": "Arbitrum Ecosystem[556]",
-	"60a5f6765abd81761fe58688": "Polygon Ecosystem[794]",
-	"63c53f177e9034437b2a93bc": "Optimism Ecosystem[159]",
-	"6051a82566fc1b42617d6dc6": "Memes[4473]",
-	"6400b58c1701313dc2e853a9": "Real World Assets[159]",
-	"604f2738ebccdd50cd175fac": "Decentralized Exchange (DEX) Token[194]",
-	"6051a81a66fc1b42617d6db7": "AI & Big Data[779]",
-	"6051a82166fc1b42617d6dc1": "Gaming[1017] (Gaming)",
-	"6051a81b66fc1b42617d6db9": "Distributed Computing[130]",
-	"604f2776ebccdd50cd175fdc": "Layer 2[56]",
-	"63ff40541701313dc2e81ead": "Generative AI[91]",
-	"6051a82366fc1b42617d6dc4": "IoT[63]",
+// categoryReport holds everything needed to print one category's line,
+// filled in concurrently by the worker pool in main.
+type categoryReport struct {
+	category Category
+	coins    []Coin
 }
 
 func main() {
-	binanceCoins := getBinanceCoins()
+	concurrency := flag.Int("concurrency", 4, "number of categories to fetch concurrently")
+	cacheTTL := flag.Duration("cache-ttl", 15*time.Minute, "how long cached CMC responses remain valid")
+	noCache := flag.Bool("no-cache", false, "bypass the on-disk response cache")
+	clean := flag.Bool("clean", false, "purge the on-disk response cache and exit")
+	exchangesFlag := flag.String("exchanges", "binance", "comma-separated list of exchanges to cross-reference coins against (binance,coinbase,bybit,okx,kraken)")
+	format := flag.String("format", "text", "output format: text, json, csv, or md")
+	output := flag.String("output", "", "file to write the report to (default: stdout)")
+	configPath := flag.String("config", defaultConfigPath(), "path to a YAML or JSON filter config file")
+	include := flag.String("include", "", "comma-separated glob/regex patterns category name or title must match")
+	exclude := flag.String("exclude", "", "comma-separated glob/regex patterns to exclude by category name or title")
+	minMarketCap := flag.Float64("min-market-cap", 0, "skip categories below this market cap")
+	// Defaults to 0: CoinGecko's categories endpoint (used on the keyless
+	// path) never populates NumTokens, so a default of 1 would filter out
+	// every category when running without CMC_API_KEY.
+	minTokens := flag.Int("min-tokens", 0, "skip categories with fewer than this many tokens")
+	flag.Parse()
+
+	renderer, err := newOutputRenderer(*format)
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		os.Exit(1)
+	}
+
+	fileConfig, err := loadFileConfig(*configPath)
+	if err != nil {
+		fmt.Printf("Error loading config: %v\n", err)
+		os.Exit(1)
+	}
 
-	// Load configuration
-	config := Config{
-		APIKey:  os.Getenv("CMC_API_KEY"), // Set your API key in environment variable CMC_API_KEY
-		BaseURL: "https://pro-api.coinmarketcap.com",
+	filter := &CategoryFilter{
+		Include:      append(fileConfig.Include, splitNonEmpty(*include)...),
+		Exclude:      append(fileConfig.Exclude, splitNonEmpty(*exclude)...),
+		MinMarketCap: maxFloat(fileConfig.MinMarketCap, *minMarketCap),
+		MinTokens:    maxInt(fileConfig.MinTokens, *minTokens),
+		Pins:         fileConfig.Pins,
 	}
 
-	if config.APIKey == "" {
-		fmt.Println("Error: CMC_API_KEY environment variable is not set")
+	fileCache, err := NewFileCache(*cacheTTL)
+	if err != nil {
+		fmt.Printf("Error initializing cache: %v\n", err)
 		os.Exit(1)
 	}
 
+	if *clean {
+		if err := fileCache.Clean(); err != nil {
+			fmt.Printf("Error cleaning cache: %v\n", err)
+			os.Exit(1)
+		}
+		fmt.Println("Cache cleaned")
+		return
+	}
+
+	var cache Cache = fileCache
+	if *noCache {
+		cache = nil
+	}
+
+	provider := newDefaultProvider(cache)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	listedCoins := getListedCoins(ctx, strings.Split(*exchangesFlag, ","))
+
 	// Step 1: Get all categories
-	categories, err := getCategories(config)
+	categories, err := provider.GetCategories(ctx)
 	if err != nil {
 		fmt.Printf("Error fetching categories: %v\n", err)
 		os.Exit(1)
@@ -105,189 +151,131 @@ func main() {
 		return categories[i].MarketCap > categories[j].MarketCap
 	})
 
-	// Step 2: Get coins for each category
+	var wanted []Category
 	for _, category := range categories {
-		if category.NumTokens == 0 || strings.Contains(strings.ToLower(category.Name), "portfolio") {
+		if strings.Contains(strings.ToLower(category.Name), "portfolio") {
 			continue
 		}
-
-		if _, ok := allowedCategories[category.Id]; !ok {
+		if !filter.Matches(category) {
 			continue
 		}
-
-		fmt.Printf("\nCategory: %s[%d] (%s)\n", category.Name, category.NumTokens, category.Title)
-		fmt.Println("ID:", category.Id)
-		fmt.Println("Description:", category.Description)
-		fmt.Printf("MarketCap B: %v (24h change: %v)\n", category.MarketCap/1_000_000_000, category.MarketCapChange)
-		fmt.Printf("Vol B: %v (24h change: %v)\n", category.Volume/1_000_000_000, category.VolumeChange)
-		fmt.Printf("Coins: [")
-		coins, err := getCoinsForCategory(config, category.Id)
-		if err != nil {
-			log.Fatal(err)
-		}
-		coinNames := make([]string, 0, len(coins))
-		for _, coin := range coins {
-			if _, exists := binanceCoins[coin.Symbol]; !exists {
-				continue
-			}
-			coinNames = append(coinNames, coin.Symbol)
+		if alias, ok := filter.Pins[category.Id]; ok && alias != "" {
+			category.Title = alias
 		}
-		fmt.Printf(strings.Join(coinNames, ", "))
-		fmt.Println("]")
-	}
-}
-
-// getCategories fetches the list of all categories from CoinMarketCap
-func getCategories(config Config) ([]Category, error) {
-	url := fmt.Sprintf("%s/v1/cryptocurrency/categories", config.BaseURL)
-	req, err := http.NewRequest("GET", url, nil)
-	if err != nil {
-		return nil, fmt.Errorf("failed to create request: %w", err)
+		wanted = append(wanted, category)
 	}
 
-	req.Header.Set("Accept", "application/json")
-	req.Header.Set("X-CMC_PRO_API_KEY", config.APIKey)
-
-	client := &http.Client{}
-	resp, err := client.Do(req)
-	if err != nil {
-		return nil, fmt.Errorf("failed to send request: %w", err)
+	// Step 2: Get coins for each category, fanned out over a bounded worker
+	// pool so requests to slow categories don't serialize behind each other.
+	// Results are kept in wanted's market-cap order regardless of which
+	// goroutine finishes first.
+	reports := make([]categoryReport, len(wanted))
+	sem := make(chan struct{}, *concurrency)
+	var wg sync.WaitGroup
+	var errOnce sync.Once
+	var fatalErr error
+
+	for i, category := range wanted {
+		wg.Add(1)
+		go func(i int, category Category) {
+			defer wg.Done()
+
+			select {
+			case sem <- struct{}{}:
+			case <-ctx.Done():
+				return
+			}
+			defer func() { <-sem }()
+
+			coins, err := provider.GetCoinsForCategory(ctx, category.Id)
+			if err != nil {
+				errOnce.Do(func() {
+					fatalErr = err
+					cancel()
+				})
+				return
+			}
+			reports[i] = categoryReport{category: category, coins: coins}
+		}(i, category)
 	}
-	defer resp.Body.Close()
+	wg.Wait()
 
-	if resp.StatusCode != http.StatusOK {
-		body, _ := io.ReadAll(resp.Body)
-		return nil, fmt.Errorf("unexpected status code: %d, response: %s", resp.StatusCode, string(body))
+	if fatalErr != nil {
+		log.Fatal(fatalErr)
 	}
 
-	body, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return nil, fmt.Errorf("failed to read response body: %w", err)
+	var out io.Writer = os.Stdout
+	if *output != "" {
+		f, err := os.Create(*output)
+		if err != nil {
+			fmt.Printf("Error creating output file: %v\n", err)
+			os.Exit(1)
+		}
+		defer f.Close()
+		out = f
 	}
 
-	var response CategoryResponse
-	if err := json.Unmarshal(body, &response); err != nil {
-		return nil, fmt.Errorf("failed to parse response: %w", err)
+	if err := renderer.Render(out, reports, listedCoins); err != nil {
+		fmt.Printf("Error rendering report: %v\n", err)
+		os.Exit(1)
 	}
-
-	return response.Data, nil
 }
 
-// getCoinsForCategory fetches the list of coins for a specific category
-func getCoinsForCategory(config Config, categoryID string) ([]Coin, error) {
-	url := fmt.Sprintf("%s/v1/cryptocurrency/category?id=%s&limit=100&convert=USD", config.BaseURL, categoryID)
-	req, err := http.NewRequest("GET", url, nil)
-	if err != nil {
-		return nil, fmt.Errorf("failed to create request: %w", err)
-	}
-
-	req.Header.Set("Accept", "application/json")
-	req.Header.Set("X-CMC_PRO_API_KEY", config.APIKey)
-
-	client := &http.Client{}
-	resp, err := client.Do(req)
-	if err != nil {
-		return nil, fmt.Errorf("failed to send request: %w", err)
-	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode != http.StatusOK {
-		body, _ := io.ReadAll(resp.Body)
-		return nil, fmt.Errorf("unexpected status code: %d, response: %s", resp.StatusCode, string(body))
+// splitNonEmpty splits s on commas, trims whitespace, and drops empty
+// entries.
+func splitNonEmpty(s string) []string {
+	var out []string
+	for _, part := range strings.Split(s, ",") {
+		part = strings.TrimSpace(part)
+		if part != "" {
+			out = append(out, part)
+		}
 	}
+	return out
+}
 
-	body, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return nil, fmt.Errorf("failed to read response body: %w", err)
+func maxFloat(a, b float64) float64 {
+	if a > b {
+		return a
 	}
+	return b
+}
 
-	var response CategoryCoinsResponse
-	if err := json.Unmarshal(body, &response); err != nil {
-		return nil, fmt.Errorf("failed to parse response: %w", err)
+func maxInt(a, b int) int {
+	if a > b {
+		return a
 	}
-
-	return response.Data.Coins, nil
+	return b
 }
 
-func getBinanceCoins() map[string]struct{} {
-	spotURL := "https://api.binance.com/api/v3/exchangeInfo"
-	usdmFuturesURL := "https://fapi.binance.com/fapi/v1/exchangeInfo"
-	coinMFuturesURL := "https://dapi.binance.com/dapi/v1/exchangeInfo"
+// getListedCoins unions the listed-symbol sets reported by each named
+// exchange, so category coins can be cross-referenced against whichever
+// venues the user cares about instead of being tied to Binance alone.
+func getListedCoins(ctx context.Context, exchangeNames []string) map[string]struct{} {
+	listedCoins := make(map[string]struct{})
 
-	// Initialize a map to store all unique assets
-	allAssets := make(map[string]struct{})
-
-	// Fetch and process spot market assets
-	if symbols, err := getExchangeInfo(spotURL); err == nil {
-		assets := extractAssets(symbols, true) // Include both baseAsset and quoteAsset
-		for k := range assets {
-			allAssets[k] = struct{}{}
+	for _, name := range exchangeNames {
+		name = strings.TrimSpace(name)
+		if name == "" {
+			continue
 		}
-	} else {
-		fmt.Println("Error fetching spot exchangeInfo:", err)
-	}
 
-	// Fetch and process USDM futures market assets
-	if symbols, err := getExchangeInfo(usdmFuturesURL); err == nil {
-		assets := extractAssets(symbols, true) // Include both baseAsset and quoteAsset
-		for k := range assets {
-			allAssets[k] = struct{}{}
+		listing, err := exchanges.Get(name)
+		if err != nil {
+			fmt.Println("Error:", err)
+			continue
 		}
-	} else {
-		fmt.Println("Error fetching USDM futures exchangeInfo:", err)
-	}
 
-	// Fetch and process COIN-M futures market assets
-	if symbols, err := getExchangeInfo(coinMFuturesURL); err == nil {
-		assets := extractAssets(symbols, false) // Include only baseAsset (exclude quoteAsset, which is "USD")
-		for k := range assets {
-			allAssets[k] = struct{}{}
+		symbols, err := listing.ListedSymbols(ctx)
+		if err != nil {
+			fmt.Printf("Error fetching %s listings: %v\n", name, err)
+			continue
 		}
-	} else {
-		fmt.Println("Error fetching COIN-M futures exchangeInfo:", err)
-	}
-	return allAssets
-}
-
-// ExchangeInfo represents the structure of the exchangeInfo API response
-type ExchangeInfo struct {
-	Symbols []Symbol `json:"symbols"`
-}
-
-// Symbol represents a trading symbol with its base and quote assets
-type Symbol struct {
-	BaseAsset  string `json:"baseAsset"`
-	QuoteAsset string `json:"quoteAsset"`
-}
-
-// getExchangeInfo fetches the exchangeInfo from the given URL and returns the list of symbols
-func getExchangeInfo(url string) ([]Symbol, error) {
-	resp, err := http.Get(url)
-	if err != nil {
-		return nil, fmt.Errorf("failed to fetch %s: %w", url, err)
-	}
-	defer resp.Body.Close()
 
-	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("unexpected status code %d for %s", resp.StatusCode, url)
-	}
-
-	var info ExchangeInfo
-	if err := json.NewDecoder(resp.Body).Decode(&info); err != nil {
-		return nil, fmt.Errorf("failed to decode response from %s: %w", url, err)
-	}
-
-	return info.Symbols, nil
-}
-
-// extractAssets extracts unique assets (baseAsset and optionally quoteAsset) from the list of symbols
-func extractAssets(symbols []Symbol, includeQuote bool) map[string]bool {
-	assets := make(map[string]bool)
-	for _, symbol := range symbols {
-		assets[symbol.BaseAsset] = true
-		if includeQuote {
-			assets[symbol.QuoteAsset] = true
+		for symbol := range symbols {
+			listedCoins[symbol] = struct{}{}
 		}
 	}
-	return assets
+
+	return listedCoins
 }
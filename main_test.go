@@ -0,0 +1,41 @@
+package main
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestSplitNonEmpty(t *testing.T) {
+	tests := []struct {
+		in   string
+		want []string
+	}{
+		{"", nil},
+		{"a,b,c", []string{"a", "b", "c"}},
+		{" a , , b ", []string{"a", "b"}},
+	}
+
+	for _, tt := range tests {
+		if got := splitNonEmpty(tt.in); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("splitNonEmpty(%q) = %v, want %v", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestMaxFloat(t *testing.T) {
+	if got := maxFloat(1.5, 2.5); got != 2.5 {
+		t.Errorf("maxFloat(1.5, 2.5) = %v, want 2.5", got)
+	}
+	if got := maxFloat(3, 2); got != 3 {
+		t.Errorf("maxFloat(3, 2) = %v, want 3", got)
+	}
+}
+
+func TestMaxInt(t *testing.T) {
+	if got := maxInt(1, 2); got != 2 {
+		t.Errorf("maxInt(1, 2) = %v, want 2", got)
+	}
+	if got := maxInt(3, 2); got != 3 {
+		t.Errorf("maxInt(3, 2) = %v, want 3", got)
+	}
+}
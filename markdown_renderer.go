@@ -0,0 +1,29 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"strings"
+)
+
+// MarkdownRenderer emits a single GitHub-flavored Markdown table, one row
+// per category.
+type MarkdownRenderer struct{}
+
+func (r *MarkdownRenderer) Render(w io.Writer, reports []categoryReport, listedCoins map[string]struct{}) error {
+	fmt.Fprintln(w, "| Category | Market Cap (B) | 24h Change | Volume (B) | Coins |")
+	fmt.Fprintln(w, "|---|---|---|---|---|")
+
+	for _, report := range reports {
+		category := report.category
+		fmt.Fprintf(w, "| %s | %v | %v | %v | %s |\n",
+			category.Name,
+			category.MarketCap/1_000_000_000,
+			category.MarketCapChange,
+			category.Volume/1_000_000_000,
+			strings.Join(reportCoinNames(report, listedCoins), ", "),
+		)
+	}
+
+	return nil
+}
@@ -0,0 +1,41 @@
+package main
+
+import (
+	"fmt"
+	"io"
+)
+
+// OutputRenderer renders the fetched category reports in a specific
+// output format, so main's reporting isn't tied to one hardcoded layout.
+type OutputRenderer interface {
+	Render(w io.Writer, reports []categoryReport, listedCoins map[string]struct{}) error
+}
+
+// newOutputRenderer looks up the OutputRenderer registered for format.
+func newOutputRenderer(format string) (OutputRenderer, error) {
+	switch format {
+	case "text":
+		return &TextRenderer{}, nil
+	case "json":
+		return &JSONRenderer{}, nil
+	case "csv":
+		return &CSVRenderer{}, nil
+	case "md":
+		return &MarkdownRenderer{}, nil
+	default:
+		return nil, fmt.Errorf("unknown output format %q (want text, json, csv, or md)", format)
+	}
+}
+
+// reportCoinNames returns the symbols from report that are listed on the
+// selected exchanges, in the same order main has always printed them.
+func reportCoinNames(report categoryReport, listedCoins map[string]struct{}) []string {
+	names := make([]string, 0, len(report.coins))
+	for _, coin := range report.coins {
+		if _, exists := listedCoins[coin.Symbol]; !exists {
+			continue
+		}
+		names = append(names, coin.Symbol)
+	}
+	return names
+}
@@ -0,0 +1,38 @@
+package main
+
+import (
+	"context"
+	"fmt"
+)
+
+// MarketDataProvider abstracts fetching category listings and the coins
+// that belong to a category from an upstream market-data source. This lets
+// main swap or chain sources (CoinMarketCap, CoinGecko, ...) without the
+// rest of the pipeline caring which one answered a given request. Every
+// call takes a context so callers can cancel in-flight requests, e.g. once
+// one category fetch has failed fatally.
+type MarketDataProvider interface {
+	// Name identifies the provider for logging and error messages.
+	Name() string
+	GetCategories(ctx context.Context) ([]Category, error)
+	GetCoinsForCategory(ctx context.Context, categoryID string) ([]Coin, error)
+}
+
+// HTTPStatusError is returned by providers when the upstream API responds
+// with a non-200 status, so callers such as FallbackProvider can inspect
+// the status code (e.g. to detect rate limiting) without parsing strings.
+type HTTPStatusError struct {
+	Provider   string
+	StatusCode int
+	Body       string
+}
+
+func (e *HTTPStatusError) Error() string {
+	return fmt.Sprintf("%s: unexpected status code: %d, response: %s", e.Provider, e.StatusCode, e.Body)
+}
+
+// RateLimited reports whether the upstream rejected the request for being
+// over its rate limit (HTTP 429).
+func (e *HTTPStatusError) RateLimited() bool {
+	return e.StatusCode == 429
+}
@@ -0,0 +1,23 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"strings"
+)
+
+// TextRenderer reproduces the tool's original human-readable stdout report.
+type TextRenderer struct{}
+
+func (r *TextRenderer) Render(w io.Writer, reports []categoryReport, listedCoins map[string]struct{}) error {
+	for _, report := range reports {
+		category := report.category
+		fmt.Fprintf(w, "\nCategory: %s[%d] (%s)\n", category.Name, category.NumTokens, category.Title)
+		fmt.Fprintln(w, "ID:", category.Id)
+		fmt.Fprintln(w, "Description:", category.Description)
+		fmt.Fprintf(w, "MarketCap B: %v (24h change: %v)\n", category.MarketCap/1_000_000_000, category.MarketCapChange)
+		fmt.Fprintf(w, "Vol B: %v (24h change: %v)\n", category.Volume/1_000_000_000, category.VolumeChange)
+		fmt.Fprintf(w, "Coins: [%s]\n", strings.Join(reportCoinNames(report, listedCoins), ", "))
+	}
+	return nil
+}